@@ -1,4 +1,10 @@
-// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// Originally generated by protoc-gen-grpc-gateway from dss.proto, then
+// hand-extended (our protoc invocation does not yet regenerate the
+// WatchChanges streaming handler or the SearchIdentificationServiceAreasByVolume/
+// SearchSubscriptionsByVolume handlers below, so DO NOT run codegen over
+// this file until that's wired up — a regen pass today would silently
+// drop those hand-added sections). See dss.pb.gw.local.go for the
+// equivalent note on its sibling local-dispatch file.
 // source: dss.proto
 
 /*
@@ -109,6 +115,52 @@ func request_DiscoveryAndSynchronizationService_GetSubscription_0(ctx context.Co
 
 }
 
+var (
+	filter_DiscoveryAndSynchronizationService_WatchChanges_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1}, Check: []int{0, 1}}
+)
+
+func request_DiscoveryAndSynchronizationService_WatchChanges_0(ctx context.Context, marshaler runtime.Marshaler, client DiscoveryAndSynchronizationServiceClient, req *http.Request, pathParams map[string]string) (DiscoveryAndSynchronizationService_WatchChangesClient, runtime.ServerMetadata, error) {
+	var protoReq WatchChangesRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+
+	protoReq.Id, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_DiscoveryAndSynchronizationService_WatchChanges_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.WatchChanges(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 var (
 	filter_DiscoveryAndSynchronizationService_PutIdentificationServiceArea_0 = &utilities.DoubleArray{Encoding: map[string]int{"extents": 0, "id": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
 )
@@ -201,6 +253,40 @@ func request_DiscoveryAndSynchronizationService_PutSubscription_0(ctx context.Co
 
 }
 
+func request_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0(ctx context.Context, marshaler runtime.Marshaler, client DiscoveryAndSynchronizationServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchIdentificationServiceAreasByVolumeRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.SearchIdentificationServiceAreasByVolume(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func request_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0(ctx context.Context, marshaler runtime.Marshaler, client DiscoveryAndSynchronizationServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchSubscriptionsByVolumeRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.SearchSubscriptionsByVolume(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 var (
 	filter_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreas_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
 )
@@ -339,6 +425,26 @@ func RegisterDiscoveryAndSynchronizationServiceHandlerClient(ctx context.Context
 
 	})
 
+	mux.Handle("GET", pattern_DiscoveryAndSynchronizationService_WatchChanges_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DiscoveryAndSynchronizationService_WatchChanges_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_DiscoveryAndSynchronizationService_WatchChanges_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("PUT", pattern_DiscoveryAndSynchronizationService_PutIdentificationServiceArea_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -379,6 +485,46 @@ func RegisterDiscoveryAndSynchronizationServiceHandlerClient(ctx context.Context
 
 	})
 
+	mux.Handle("POST", pattern_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("POST", pattern_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("GET", pattern_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreas_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -429,16 +575,28 @@ var (
 
 	pattern_DiscoveryAndSynchronizationService_GetSubscription_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"dss", "subscriptions", "id"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_DiscoveryAndSynchronizationService_WatchChanges_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"dss", "subscriptions", "id"}, "watch", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_DiscoveryAndSynchronizationService_PutIdentificationServiceArea_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"dss", "identification_service_areas", "id"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_DiscoveryAndSynchronizationService_PutSubscription_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"dss", "subscriptions", "id"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"dss", "identification_service_areas"}, "search", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"dss", "subscriptions"}, "search", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreas_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"dss", "identification_service_areas"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_DiscoveryAndSynchronizationService_SearchSubscriptions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"dss", "subscriptions"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
+	forward_DiscoveryAndSynchronizationService_SearchIdentificationServiceAreasByVolume_0 = runtime.ForwardResponseMessage
+
+	forward_DiscoveryAndSynchronizationService_SearchSubscriptionsByVolume_0 = runtime.ForwardResponseMessage
+
+	forward_DiscoveryAndSynchronizationService_WatchChanges_0 = runtime.ForwardResponseStream
+
 	forward_DiscoveryAndSynchronizationService_DeleteIdentificationServiceArea_0 = runtime.ForwardResponseMessage
 
 	forward_DiscoveryAndSynchronizationService_DeleteSubscription_0 = runtime.ForwardResponseMessage